@@ -0,0 +1,15 @@
+// Package tree_sitter_quipu provides Go bindings to the quipu tree-sitter grammar.
+package tree_sitter_quipu
+
+// #cgo CFLAGS: -std=c11 -fPIC -I../../src
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter Language for this grammar, suitable for
+// passing to tree_sitter.NewLanguage.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_quipu())
+}
@@ -0,0 +1,344 @@
+package tree_sitter_quipu
+
+import (
+	"fmt"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Parser parses quipu source into a typed Document. It is a thin wrapper
+// around a tree_sitter.Parser preconfigured with the quipu grammar.
+type Parser struct {
+	inner *tree_sitter.Parser
+}
+
+// NewParser returns a Parser ready to parse quipu source.
+func NewParser() (*Parser, error) {
+	inner := tree_sitter.NewParser()
+	language := tree_sitter.NewLanguage(Language())
+	if err := inner.SetLanguage(language); err != nil {
+		return nil, fmt.Errorf("tree_sitter_quipu: set language: %w", err)
+	}
+	return &Parser{inner: inner}, nil
+}
+
+// Parse parses source and returns its root Document.
+func (p *Parser) Parse(source []byte) (*Document, error) {
+	tree := p.inner.Parse(source, nil)
+	if tree == nil {
+		return nil, fmt.Errorf("tree_sitter_quipu: parse failed")
+	}
+	root := tree.RootNode()
+	if root == nil || root.Kind() != "document" {
+		return nil, fmt.Errorf("tree_sitter_quipu: unexpected root node")
+	}
+	return &Document{node{root, source}}, nil
+}
+
+// Close releases resources held by the underlying tree_sitter.Parser.
+func (p *Parser) Close() {
+	p.inner.Close()
+}
+
+// node is the shared base embedded by every typed wrapper. It pairs a raw
+// tree_sitter.Node with the source bytes it was parsed from, so that typed
+// accessors can slice out text without the caller re-threading the buffer.
+type node struct {
+	n      *tree_sitter.Node
+	source []byte
+}
+
+// Kind returns the grammar rule name of the underlying node (e.g. "cord").
+func (w node) Kind() string { return w.n.Kind() }
+
+// Text returns the source text spanned by the node.
+func (w node) Text() string { return string(w.source[w.n.StartByte():w.n.EndByte()]) }
+
+// StartByte returns the byte offset of the start of the node.
+func (w node) StartByte() uint { return w.n.StartByte() }
+
+// EndByte returns the byte offset of the end of the node.
+func (w node) EndByte() uint { return w.n.EndByte() }
+
+// StartPoint returns the row/column of the start of the node.
+func (w node) StartPoint() tree_sitter.Point { return w.n.StartPosition() }
+
+// EndPoint returns the row/column of the end of the node.
+func (w node) EndPoint() tree_sitter.Point { return w.n.EndPosition() }
+
+func (w node) child(name string) *tree_sitter.Node {
+	return w.n.ChildByFieldName(name)
+}
+
+func (w node) namedChildren(kind string) []*tree_sitter.Node {
+	var out []*tree_sitter.Node
+	count := w.n.NamedChildCount()
+	for i := uint(0); i < count; i++ {
+		c := w.n.NamedChild(i)
+		if c != nil && c.Kind() == kind {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Document is the root node of a parsed quipu source file: a sequence of
+// cords and top-level comments.
+type Document struct {
+	node
+}
+
+// Cords returns the cords declared at the top level of the document.
+func (d *Document) Cords() []*Cord {
+	raw := d.namedChildren("cord")
+	out := make([]*Cord, len(raw))
+	for i, n := range raw {
+		out[i] = &Cord{node{n, d.source}}
+	}
+	return out
+}
+
+// Comments returns the top-level comments in the document.
+func (d *Document) Comments() []*Comment {
+	raw := d.namedChildren("comment")
+	out := make([]*Comment, len(raw))
+	for i, n := range raw {
+		out[i] = &Comment{node{n, d.source}}
+	}
+	return out
+}
+
+// Cord is a named cord declaration, optionally annotated with a color and
+// ending in a pendant string, carrying one or more knot groups.
+type Cord struct {
+	node
+}
+
+// Name returns the cord's identifier.
+func (c *Cord) Name() string {
+	if n := c.child("name"); n != nil {
+		return string(c.source[n.StartByte():n.EndByte()])
+	}
+	return ""
+}
+
+// Color returns the cord's color annotation, if present.
+func (c *Cord) Color() (string, bool) {
+	n := c.child("color")
+	if n == nil {
+		return "", false
+	}
+	return string(c.source[n.StartByte():n.EndByte()]), true
+}
+
+// Knots returns the knots across every knot group on this cord.
+func (c *Cord) Knots() []*Knot {
+	var out []*Knot
+	for _, g := range c.KnotGroups() {
+		out = append(out, g.Knots()...)
+	}
+	return out
+}
+
+// KnotGroups returns the cord's knot groups in source order.
+func (c *Cord) KnotGroups() []*KnotGroup {
+	raw := c.namedChildren("knot_group")
+	out := make([]*KnotGroup, len(raw))
+	for i, n := range raw {
+		out[i] = &KnotGroup{node{n, c.source}}
+	}
+	return out
+}
+
+// Pendant returns the cord's trailing pendant string, if present.
+func (c *Cord) Pendant() (*Pendant, bool) {
+	raw := c.namedChildren("pendant")
+	if len(raw) == 0 {
+		return nil, false
+	}
+	return &Pendant{node{raw[0], c.source}}, true
+}
+
+// KnotGroup is a cluster of knots of the same kind (long, figure-eight, or
+// single).
+type KnotGroup struct {
+	node
+}
+
+// KindKeyword returns the knot group's kind keyword ("long", "figure-eight",
+// or "single"). It is named to avoid colliding with the embedded node.Kind,
+// which returns the grammar node type ("knot_group").
+func (g *KnotGroup) KindKeyword() string {
+	if n := g.child("kind"); n != nil {
+		return string(g.source[n.StartByte():n.EndByte()])
+	}
+	return ""
+}
+
+// Knots returns the knots belonging to this group, in tied order.
+func (g *KnotGroup) Knots() []*Knot {
+	raw := g.namedChildren("knot")
+	out := make([]*Knot, len(raw))
+	for i, n := range raw {
+		out[i] = &Knot{node{n, g.source}}
+	}
+	return out
+}
+
+// Knot is a single tied knot encoding a numeric value, optionally carrying a
+// subsidiary cord.
+type Knot struct {
+	node
+}
+
+// Value returns the knot's numeric value as source text.
+func (k *Knot) Value() string {
+	if n := k.child("value"); n != nil {
+		return string(k.source[n.StartByte():n.EndByte()])
+	}
+	return ""
+}
+
+// Subsidiary returns the knot's attached subsidiary cord, if present.
+func (k *Knot) Subsidiary() (*Subsidiary, bool) {
+	n := k.child("subsidiary")
+	if n == nil {
+		return nil, false
+	}
+	return &Subsidiary{node{n, k.source}}, true
+}
+
+// Subsidiary is a secondary cord hanging off a knot.
+type Subsidiary struct {
+	node
+}
+
+// Name returns the subsidiary cord's identifier.
+func (s *Subsidiary) Name() string {
+	if n := s.child("name"); n != nil {
+		return string(s.source[n.StartByte():n.EndByte()])
+	}
+	return ""
+}
+
+// KnotGroups returns the subsidiary's own knot groups.
+func (s *Subsidiary) KnotGroups() []*KnotGroup {
+	raw := s.namedChildren("knot_group")
+	out := make([]*KnotGroup, len(raw))
+	for i, n := range raw {
+		out[i] = &KnotGroup{node{n, s.source}}
+	}
+	return out
+}
+
+// Pendant is a free-form string hanging off a cord.
+type Pendant struct {
+	node
+}
+
+// Text returns the pendant's quoted text, unquoted.
+func (p *Pendant) Text() string {
+	if n := p.child("text"); n != nil {
+		return string(p.source[n.StartByte():n.EndByte()])
+	}
+	return ""
+}
+
+// Comment is either a line comment or a block comment.
+type Comment struct {
+	node
+}
+
+// Visitor is implemented by callers that want to walk a Document's tree.
+// Enter is called before a node's children are visited and Leave after;
+// returning false from Enter skips that node's children (Leave is still
+// called).
+type Visitor interface {
+	Enter(n Node) bool
+	Leave(n Node)
+}
+
+// Node is the interface satisfied by every typed wrapper, allowing Walk to
+// treat them uniformly.
+type Node interface {
+	Kind() string
+	Text() string
+	StartByte() uint
+	EndByte() uint
+}
+
+// Walk traverses doc depth-first, calling v's Enter and Leave for every
+// cord, knot group, knot, subsidiary, and pendant it contains, and for each
+// of the document's top-level comments (visited before the cords). Comments
+// nested inside a cord are not visited.
+func Walk(doc *Document, v Visitor) {
+	for _, c := range doc.Comments() {
+		walkComment(c, v)
+	}
+	for _, c := range doc.Cords() {
+		walkCord(c, v)
+	}
+}
+
+func walkCord(c *Cord, v Visitor) {
+	if !v.Enter(c) {
+		v.Leave(c)
+		return
+	}
+	for _, g := range c.KnotGroups() {
+		walkKnotGroup(g, v)
+	}
+	if p, ok := c.Pendant(); ok {
+		walkPendant(p, v)
+	}
+	v.Leave(c)
+}
+
+func walkKnotGroup(g *KnotGroup, v Visitor) {
+	if !v.Enter(g) {
+		v.Leave(g)
+		return
+	}
+	for _, k := range g.Knots() {
+		walkKnot(k, v)
+	}
+	v.Leave(g)
+}
+
+func walkKnot(k *Knot, v Visitor) {
+	if !v.Enter(k) {
+		v.Leave(k)
+		return
+	}
+	if s, ok := k.Subsidiary(); ok {
+		walkSubsidiary(s, v)
+	}
+	v.Leave(k)
+}
+
+func walkSubsidiary(s *Subsidiary, v Visitor) {
+	if !v.Enter(s) {
+		v.Leave(s)
+		return
+	}
+	for _, g := range s.KnotGroups() {
+		walkKnotGroup(g, v)
+	}
+	v.Leave(s)
+}
+
+func walkPendant(p *Pendant, v Visitor) {
+	if !v.Enter(p) {
+		v.Leave(p)
+		return
+	}
+	v.Leave(p)
+}
+
+func walkComment(c *Comment, v Visitor) {
+	if !v.Enter(c) {
+		v.Leave(c)
+		return
+	}
+	v.Leave(c)
+}
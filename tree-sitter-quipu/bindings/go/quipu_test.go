@@ -0,0 +1,112 @@
+package tree_sitter_quipu_test
+
+import (
+	"testing"
+
+	tree_sitter_quipu "github.com/waddie/quipu/bindings/go"
+)
+
+const sample = `main: #ff0000
+  [long
+    3
+  ]
+  [figure-eight
+    4 -> carryover
+      [long
+        1
+      ]
+  ]
+  "running total"
+`
+
+func parseSample(t *testing.T) *tree_sitter_quipu.Document {
+	t.Helper()
+
+	parser, err := tree_sitter_quipu.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser: %v", err)
+	}
+	t.Cleanup(parser.Close)
+
+	doc, err := parser.Parse([]byte(sample))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return doc
+}
+
+func TestDocumentCords(t *testing.T) {
+	doc := parseSample(t)
+
+	cords := doc.Cords()
+	if len(cords) != 1 {
+		t.Fatalf("got %d cords, want 1", len(cords))
+	}
+
+	cord := cords[0]
+	if got := cord.Name(); got != "main" {
+		t.Errorf("Name() = %q, want %q", got, "main")
+	}
+	if color, ok := cord.Color(); !ok || color != "#ff0000" {
+		t.Errorf("Color() = (%q, %v), want (%q, true)", color, ok, "#ff0000")
+	}
+	if pendant, ok := cord.Pendant(); !ok || pendant.Text() != "running total" {
+		t.Errorf("Pendant() text = %q, ok = %v, want %q, true", pendant.Text(), ok, "running total")
+	}
+}
+
+func TestCordKnotsAndSubsidiary(t *testing.T) {
+	doc := parseSample(t)
+	cord := doc.Cords()[0]
+
+	knots := cord.Knots()
+	if len(knots) != 2 {
+		t.Fatalf("got %d knots, want 2", len(knots))
+	}
+
+	sub, ok := knots[1].Subsidiary()
+	if !ok {
+		t.Fatalf("expected second knot to have a subsidiary")
+	}
+	if got := sub.Name(); got != "carryover" {
+		t.Errorf("Subsidiary Name() = %q, want %q", got, "carryover")
+	}
+	if groups := sub.KnotGroups(); len(groups) != 1 || len(groups[0].Knots()) != 1 {
+		t.Errorf("subsidiary knot groups = %v, want exactly one group with one knot", groups)
+	}
+}
+
+func TestWalkVisitsEveryNode(t *testing.T) {
+	doc := parseSample(t)
+
+	var kinds []string
+	tree_sitter_quipu.Walk(doc, visitorFunc{
+		enter: func(n tree_sitter_quipu.Node) bool {
+			kinds = append(kinds, n.Kind())
+			return true
+		},
+	})
+
+	want := []string{"cord", "knot_group", "knot", "knot_group", "knot", "subsidiary", "knot_group", "knot", "pendant"}
+	if len(kinds) != len(want) {
+		t.Fatalf("visited %v, want %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Errorf("visited[%d] = %q, want %q", i, kinds[i], want[i])
+		}
+	}
+}
+
+type visitorFunc struct {
+	enter func(tree_sitter_quipu.Node) bool
+}
+
+func (v visitorFunc) Enter(n tree_sitter_quipu.Node) bool {
+	if v.enter == nil {
+		return true
+	}
+	return v.enter(n)
+}
+
+func (v visitorFunc) Leave(tree_sitter_quipu.Node) {}
@@ -0,0 +1,23 @@
+// Package smacker provides a quipu binding for github.com/smacker/go-tree-sitter,
+// for consumers (such as forest-style aggregator repos) built on that API
+// instead of github.com/tree-sitter/go-tree-sitter. It is its own Go module
+// so that picking this binding does not pull in the other one's dependency
+// tree, and vice versa.
+package smacker
+
+// #cgo CFLAGS: -std=c11 -fPIC -I../../../src
+// #include "../../../src/parser.c"
+// #include "../../../src/scanner.c"
+import "C"
+
+import (
+	"unsafe"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// GetLanguage returns the quipu grammar as a *sitter.Language, ready to pass
+// to sitter.Parser.SetLanguage.
+func GetLanguage() *sitter.Language {
+	return sitter.NewLanguage(unsafe.Pointer(C.tree_sitter_quipu()))
+}
@@ -0,0 +1,18 @@
+package smacker_test
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/waddie/quipu/bindings/go/smacker"
+)
+
+func TestCanLoadGrammar(t *testing.T) {
+	language := smacker.GetLanguage()
+	if language == nil {
+		t.Fatal("Error loading quipu grammar")
+	}
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(language)
+}